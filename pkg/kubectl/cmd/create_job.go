@@ -19,14 +19,17 @@ package cmd
 import (
 	"fmt"
 	"io"
+	"strings"
 
 	"github.com/spf13/cobra"
 
 	batchv1 "k8s.io/api/batch/v1"
 	batchv1beta1 "k8s.io/api/batch/v1beta1"
 	batchv2alpha1 "k8s.io/api/batch/v2alpha1"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	clientbatchv1 "k8s.io/client-go/kubernetes/typed/batch/v1"
+	clientbatchv1beta1 "k8s.io/client-go/kubernetes/typed/batch/v1beta1"
 	"k8s.io/kubernetes/pkg/kubectl/cmd/templates"
 	cmdutil "k8s.io/kubernetes/pkg/kubectl/cmd/util"
 	"k8s.io/kubernetes/pkg/kubectl/resource"
@@ -39,20 +42,34 @@ var (
 
 	jobExample = templates.Examples(i18n.T(`
 		# Create a job from a CronJob named "a-cronjob"
-		kubectl create job test-job --from=cronjob/a-cronjob`))
+		kubectl create job test-job --from=cronjob/a-cronjob
+
+		# Create a job with a command
+		kubectl create job test-job --image=busybox -- date
+
+		# Create a CronJob with a command
+		kubectl create job test-job --image=busybox --schedule="*/1 * * * *" -- date`))
 )
 
 type CreateJobOptions struct {
-	Name string
-	From string
-
-	Namespace    string
-	OutputFormat string
-	Client       clientbatchv1.BatchV1Interface
-	Out          io.Writer
-	DryRun       bool
-	Builder      *resource.Builder
-	Cmd          *cobra.Command
+	Name     string
+	From     string
+	Image    string
+	Command  []string
+	Schedule string
+
+	Namespace     string
+	OutputFormat  string
+	Client        clientbatchv1.BatchV1Interface
+	CronJobClient clientbatchv1beta1.BatchV1beta1Interface
+	Out           io.Writer
+	// DryRun is client-side only: this tree's typed batch clients take a
+	// single-argument Create(obj) with no CreateOptions, so server-side
+	// dry-run (CreateOptions{DryRun: [DryRunAll]}) cannot be expressed
+	// without a client-go vendor bump.
+	DryRun  bool
+	Builder *resource.Builder
+	Cmd     *cobra.Command
 }
 
 // NewCmdCreateJob is a command to ease creating Jobs from CronJobs.
@@ -61,12 +78,13 @@ func NewCmdCreateJob(f cmdutil.Factory, cmdOut io.Writer) *cobra.Command {
 		Out: cmdOut,
 	}
 	cmd := &cobra.Command{
-		Use:     "job NAME [--from=CRONJOB]",
+		Use:     "job NAME --image=image [--from=CRONJOB] [--schedule=SCHEDULE] -- [COMMAND] [args...]",
 		Short:   jobLong,
 		Long:    jobLong,
 		Example: jobExample,
 		Run: func(cmd *cobra.Command, args []string) {
 			cmdutil.CheckErr(c.Complete(f, cmd, args))
+			cmdutil.CheckErr(c.Validate())
 			cmdutil.CheckErr(c.RunCreateJob())
 		},
 	}
@@ -75,6 +93,8 @@ func NewCmdCreateJob(f cmdutil.Factory, cmdOut io.Writer) *cobra.Command {
 	cmdutil.AddPrinterFlags(cmd)
 	cmdutil.AddDryRunFlag(cmd)
 	cmd.Flags().String("from", "", "The name of the resource to create a Job from (only cronjob is supported).")
+	cmd.Flags().String("image", "", "Image name to run.")
+	cmd.Flags().String("schedule", "", "A schedule in the Cron format the job should be run with.")
 
 	return cmd
 }
@@ -85,7 +105,13 @@ func (c *CreateJobOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args
 	}
 	c.Name = args[0]
 
+	if cmd.ArgsLenAtDash() != -1 {
+		c.Command = args[cmd.ArgsLenAtDash():]
+	}
+
 	c.From = cmdutil.GetFlagString(cmd, "from")
+	c.Image = cmdutil.GetFlagString(cmd, "image")
+	c.Schedule = cmdutil.GetFlagString(cmd, "schedule")
 	c.Namespace, _, err = f.DefaultNamespace()
 	if err != nil {
 		return err
@@ -96,6 +122,7 @@ func (c *CreateJobOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args
 		return err
 	}
 	c.Client = clientset.BatchV1()
+	c.CronJobClient = clientset.BatchV1beta1()
 	c.Builder = f.NewBuilder()
 	c.DryRun = cmdutil.GetDryRunFlag(cmd)
 	c.Cmd = cmd
@@ -104,7 +131,41 @@ func (c *CreateJobOptions) Complete(f cmdutil.Factory, cmd *cobra.Command, args
 	return nil
 }
 
+// Validate makes sure there is no discrepancy in command options.
+func (c *CreateJobOptions) Validate() error {
+	if (len(c.From) == 0) == (len(c.Image) == 0) {
+		return fmt.Errorf("either --from or --image must be specified")
+	}
+	if len(c.From) > 0 && len(c.Command) > 0 {
+		return fmt.Errorf("cannot specify a command with --from")
+	}
+	if len(c.From) > 0 && len(c.Schedule) > 0 {
+		return fmt.Errorf("cannot specify --schedule with --from")
+	}
+	if len(c.Schedule) > 0 {
+		return validateScheduleFormat(c.Schedule)
+	}
+	return nil
+}
+
+// validateScheduleFormat rejects the "TZ=" and "CRON_TZ=" schedule prefixes,
+// which are not accepted by the CronJob API.
+func validateScheduleFormat(schedule string) error {
+	upper := strings.ToUpper(strings.TrimSpace(schedule))
+	if strings.HasPrefix(upper, "TZ=") || strings.HasPrefix(upper, "CRON_TZ=") {
+		return fmt.Errorf("schedule %q must not carry a TZ or CRON_TZ variable", schedule)
+	}
+	return nil
+}
+
 func (c *CreateJobOptions) RunCreateJob() error {
+	if len(c.Image) > 0 {
+		if len(c.Schedule) > 0 {
+			return c.createCronJob(c.generateJobSpec())
+		}
+		return c.createJob(nil, nil, c.generateJobSpec())
+	}
+
 	infos, err := c.Builder.
 		Unstructured().
 		NamespaceParam(c.Namespace).DefaultNamespace().
@@ -120,6 +181,9 @@ func (c *CreateJobOptions) RunCreateJob() error {
 		return fmt.Errorf("from must be an existing cronjob")
 	}
 
+	// Stable batch/v1 CronJob (GA in 1.21+) has no type in this tree's vendored
+	// k8s.io/api/batch/v1, so only the beta1/v2alpha1 CronJob versions can be
+	// recognized here without a vendor bump.
 	switch cronJob := infos[0].AsVersioned().(type) {
 	case *batchv1beta1.CronJob:
 		return c.createJobFromCronJobv1beta1(cronJob)
@@ -137,7 +201,9 @@ func (c *CreateJobOptions) RunCreateJob() error {
 
 func (c *CreateJobOptions) createJob(jobAnnotations, jobLabels map[string]string, jobSpec batchv1.JobSpec) error {
 	annotations := make(map[string]string)
-	annotations["cronjob.kubernetes.io/instantiate"] = "manual"
+	if len(c.From) > 0 {
+		annotations["cronjob.kubernetes.io/instantiate"] = "manual"
+	}
 	for k, v := range jobAnnotations {
 		annotations[k] = v
 	}
@@ -150,6 +216,7 @@ func (c *CreateJobOptions) createJob(jobAnnotations, jobLabels map[string]string
 		},
 		Spec: jobSpec,
 	}
+	job.GetObjectKind().SetGroupVersionKind(batchv1.SchemeGroupVersion.WithKind("Job"))
 
 	if !c.DryRun {
 		var err error
@@ -167,6 +234,56 @@ func (c *CreateJobOptions) createJob(jobAnnotations, jobLabels map[string]string
 	return cmdutil.PrintObject(c.Cmd, job, c.Out)
 }
 
+func (c *CreateJobOptions) createCronJob(jobSpec batchv1.JobSpec) error {
+	cronJob := &batchv1beta1.CronJob{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      c.Name,
+			Namespace: c.Namespace,
+		},
+		Spec: batchv1beta1.CronJobSpec{
+			Schedule: c.Schedule,
+			JobTemplate: batchv1beta1.JobTemplateSpec{
+				Spec: jobSpec,
+			},
+		},
+	}
+	cronJob.GetObjectKind().SetGroupVersionKind(batchv1beta1.SchemeGroupVersion.WithKind("CronJob"))
+
+	if !c.DryRun {
+		var err error
+		cronJob, err = c.CronJobClient.CronJobs(c.Namespace).Create(cronJob)
+		if err != nil {
+			return fmt.Errorf("failed to create cronjob: %v", err)
+		}
+	}
+
+	if useShortOutput := c.OutputFormat == "name"; useShortOutput || len(c.OutputFormat) == 0 {
+		cmdutil.PrintSuccess(useShortOutput, c.Out, cronJob, c.DryRun, "created")
+		return nil
+	}
+
+	return cmdutil.PrintObject(c.Cmd, cronJob, c.Out)
+}
+
+// generateJobSpec builds a JobSpec for a single-container Job from the
+// --image and trailing command/args the user supplied on the command line.
+func (c *CreateJobOptions) generateJobSpec() batchv1.JobSpec {
+	return batchv1.JobSpec{
+		Template: corev1.PodTemplateSpec{
+			Spec: corev1.PodSpec{
+				Containers: []corev1.Container{
+					{
+						Name:    c.Name,
+						Image:   c.Image,
+						Command: c.Command,
+					},
+				},
+				RestartPolicy: corev1.RestartPolicyOnFailure,
+			},
+		},
+	}
+}
+
 func (c *CreateJobOptions) createJobFromCronJobv1beta1(cronJob *batchv1beta1.CronJob) error {
 	return c.createJob(
 		cronJob.Spec.JobTemplate.Annotations,
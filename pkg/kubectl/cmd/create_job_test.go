@@ -0,0 +1,201 @@
+/*
+Copyright 2018 The Kubernetes Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cmd
+
+import (
+	"bytes"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCreateJobValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		options CreateJobOptions
+		wantErr bool
+	}{
+		{
+			name:    "neither from nor image",
+			options: CreateJobOptions{},
+			wantErr: true,
+		},
+		{
+			name:    "both from and image",
+			options: CreateJobOptions{From: "cronjob/a-cronjob", Image: "busybox"},
+			wantErr: true,
+		},
+		{
+			name:    "from only",
+			options: CreateJobOptions{From: "cronjob/a-cronjob"},
+			wantErr: false,
+		},
+		{
+			name:    "image only",
+			options: CreateJobOptions{Image: "busybox"},
+			wantErr: false,
+		},
+		{
+			name:    "command with from",
+			options: CreateJobOptions{From: "cronjob/a-cronjob", Command: []string{"date"}},
+			wantErr: true,
+		},
+		{
+			name:    "schedule with from",
+			options: CreateJobOptions{From: "cronjob/a-cronjob", Schedule: "*/1 * * * *"},
+			wantErr: true,
+		},
+		{
+			name:    "image with schedule",
+			options: CreateJobOptions{Image: "busybox", Schedule: "*/1 * * * *"},
+			wantErr: false,
+		},
+		{
+			name:    "image with TZ schedule",
+			options: CreateJobOptions{Image: "busybox", Schedule: "TZ=UTC */1 * * * *"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.options.Validate()
+			if (err != nil) != tt.wantErr {
+				t.Errorf("Validate() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestGenerateJobSpec(t *testing.T) {
+	c := &CreateJobOptions{
+		Name:    "test-job",
+		Image:   "busybox",
+		Command: []string{"date"},
+	}
+
+	spec := c.generateJobSpec()
+
+	if len(spec.Template.Spec.Containers) != 1 {
+		t.Fatalf("expected 1 container, got %d", len(spec.Template.Spec.Containers))
+	}
+	container := spec.Template.Spec.Containers[0]
+	if container.Name != c.Name {
+		t.Errorf("container name = %q, want %q", container.Name, c.Name)
+	}
+	if container.Image != c.Image {
+		t.Errorf("container image = %q, want %q", container.Image, c.Image)
+	}
+	if len(container.Command) != 1 || container.Command[0] != "date" {
+		t.Errorf("container command = %v, want [date]", container.Command)
+	}
+	if spec.Template.Spec.RestartPolicy != corev1.RestartPolicyOnFailure {
+		t.Errorf("restart policy = %v, want %v", spec.Template.Spec.RestartPolicy, corev1.RestartPolicyOnFailure)
+	}
+}
+
+func TestValidateScheduleFormat(t *testing.T) {
+	tests := []struct {
+		schedule string
+		wantErr  bool
+	}{
+		{schedule: "*/1 * * * *", wantErr: false},
+		{schedule: "TZ=UTC */1 * * * *", wantErr: true},
+		{schedule: "CRON_TZ=UTC */1 * * * *", wantErr: true},
+		{schedule: "tz=UTC */1 * * * *", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.schedule, func(t *testing.T) {
+			err := validateScheduleFormat(tt.schedule)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("validateScheduleFormat(%q) error = %v, wantErr %v", tt.schedule, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestCreateCronJob(t *testing.T) {
+	clientset := fake.NewSimpleClientset()
+	out := &bytes.Buffer{}
+	c := &CreateJobOptions{
+		Name:          "test-job",
+		Image:         "busybox",
+		Command:       []string{"date"},
+		Schedule:      "*/1 * * * *",
+		Namespace:     "default",
+		Out:           out,
+		CronJobClient: clientset.BatchV1beta1(),
+	}
+
+	if err := c.createCronJob(c.generateJobSpec()); err != nil {
+		t.Fatalf("createCronJob() error = %v", err)
+	}
+
+	created, err := clientset.BatchV1beta1().CronJobs("default").Get("test-job", metav1.GetOptions{})
+	if err != nil {
+		t.Fatalf("expected CronJob to be created: %v", err)
+	}
+	if created.Spec.Schedule != c.Schedule {
+		t.Errorf("cronjob schedule = %q, want %q", created.Spec.Schedule, c.Schedule)
+	}
+	if len(created.Spec.JobTemplate.Spec.Template.Spec.Containers) != 1 {
+		t.Fatalf("expected 1 container in job template, got %d", len(created.Spec.JobTemplate.Spec.Template.Spec.Containers))
+	}
+	if created.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Image != c.Image {
+		t.Errorf("job template image = %q, want %q", created.Spec.JobTemplate.Spec.Template.Spec.Containers[0].Image, c.Image)
+	}
+}
+
+func TestCreateJobInstantiateAnnotation(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		want bool
+	}{
+		{name: "from image, no from", from: "", want: false},
+		{name: "from cronjob", from: "cronjob/a-cronjob", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			clientset := fake.NewSimpleClientset()
+			c := &CreateJobOptions{
+				Name:      "test-job",
+				From:      tt.from,
+				Namespace: "default",
+				Out:       &bytes.Buffer{},
+				Client:    clientset.BatchV1(),
+			}
+
+			if err := c.createJob(nil, nil, c.generateJobSpec()); err != nil {
+				t.Fatalf("createJob() error = %v", err)
+			}
+
+			created, err := clientset.BatchV1().Jobs("default").Get("test-job", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("expected Job to be created: %v", err)
+			}
+			_, got := created.Annotations["cronjob.kubernetes.io/instantiate"]
+			if got != tt.want {
+				t.Errorf("instantiate annotation present = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}